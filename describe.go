@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Short returns a compact identifier for a Record suitable for list output:
+// the first 12 characters of the version string followed by the hostname,
+// e.g. "mpp s R11.1.-node03".
+func (r *Record) Short() string {
+	version := r.Version
+	if len(version) > 12 {
+		version = version[:12]
+	}
+	return fmt.Sprintf("%s-%s", version, r.Hostname)
+}
+
+// Describe renders a single Record to w in the requested format: "human"
+// (the default), "json" or "yaml". Unlike the NDJSON/CSV/Elasticsearch sinks,
+// which flatten a Record down to recordDoc for a tabular destination, "json"
+// and "yaml" here marshal the full Record tree - including SvnVersion, the
+// other header fields recordDoc omits, and the Events/Progress slices - so
+// describe is the one output mode that can surface everything a Record
+// holds.
+func (cli *CLI) Describe(record *Record, format string, w io.Writer) error {
+	switch format {
+	case "", "human":
+		return describeHuman(record, w)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(record)
+	case "yaml":
+		enc := yaml.NewEncoder(w)
+		defer enc.Close()
+		return enc.Encode(record)
+	default:
+		return fmt.Errorf("describe: unknown output format %q", format)
+	}
+}
+
+func describeHuman(record *Record, w io.Writer) error {
+	fmt.Fprintf(w, "Version:            %s\n", record.Version)
+	fmt.Fprintf(w, "Revision:           %d\n", record.Revision)
+	fmt.Fprintf(w, "Licensed To:        %s\n", record.LicensedTo)
+	fmt.Fprintf(w, "Platform:           %s\n", record.Platform)
+	fmt.Fprintf(w, "Hostname:           %s\n", record.Hostname)
+	fmt.Fprintf(w, "Input File:         %s\n", record.InputFile)
+	fmt.Fprintf(w, "Num CPUs:           %d\n", record.NumCpus)
+	fmt.Fprintf(w, "Elapsed Time:       %.0fs\n", record.ElapsedTime)
+	fmt.Fprintf(w, "Normal Termination: %t\n", record.NormalTermination)
+
+	if len(record.Parents) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tCPU SEC\tCPU %\tCLOCK SEC\tCLOCK %")
+	for _, parent := range record.Parents {
+		fmt.Fprintf(tw, "%s\t%.2f\t%.1f\t%.2f\t%.1f\n",
+			parent.Name, parent.CpuSec, parent.CpuPercent, parent.ClockSec, parent.ClockPercent)
+		for _, child := range parent.Children {
+			fmt.Fprintf(tw, "  %s\t%.2f\t%.1f\t%.2f\t%.1f\n",
+				child.Name, child.CpuSec, child.CpuPercent, child.ClockSec, child.ClockPercent)
+		}
+	}
+	return tw.Flush()
+}
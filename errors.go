@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// multiError aggregates multiple errors encountered while processing a batch
+// (e.g. one per failed file in ParseMessageFiles) into a single error value.
+type multiError []error
+
+func (m multiError) Error() string {
+	if len(m) == 1 {
+		return m[0].Error()
+	}
+	lines := make([]string, len(m))
+	for i, err := range m {
+		lines[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred:\n\t%s", len(m), strings.Join(lines, "\n\t"))
+}
+
+// errOrNil returns nil if m is empty, so callers can return it directly as
+// the error result without an explicit len check at every call site.
+func (m multiError) errOrNil() error {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
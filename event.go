@@ -0,0 +1,107 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Severity is the inferred importance of an Event.
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+// Event is a single classified line from the body of a message file: a
+// warning, an error, a termination notice, a memory report, and so on.
+// Fields that a given line doesn't carry (Time, Cycle, Code) are left zero.
+type Event struct {
+	Kind     string   `json:"kind" yaml:"kind"`
+	Severity Severity `json:"severity" yaml:"severity"`
+	Time     float64  `json:"time,omitempty" yaml:"time,omitempty"`
+	Cycle    int64    `json:"cycle,omitempty" yaml:"cycle,omitempty"`
+	Code     string   `json:"code,omitempty" yaml:"code,omitempty"`
+	Message  string   `json:"message" yaml:"message"`
+}
+
+// ProgressSample is one "cycle ... time ... dt ..." solver progress line.
+type ProgressSample struct {
+	Cycle int64   `json:"cycle" yaml:"cycle"`
+	Time  float64 `json:"time" yaml:"time"`
+	Dt    float64 `json:"dt" yaml:"dt"`
+}
+
+var (
+	eventCodeRe  = regexp.MustCompile(`\b([A-Za-z]+\+\d+)\b`)
+	eventTimeRe  = regexp.MustCompile(`at time\s*=?\s*([0-9.eE+-]+)`)
+	eventCycleRe = regexp.MustCompile(`cycle\s*=?\s*(\d+)`)
+
+	progressRe = regexp.MustCompile(`(?i)cycle\s*=?\s*(\d+)[,\s]+time\s*=\s*([0-9.eE+-]+)[,\s]+(?:timestep|dt)\s*=\s*([0-9.eE+-]+)`)
+)
+
+// classifyLine attempts to classify a single line as an Event. It returns
+// false for lines that don't match any recognized shape.
+func classifyLine(line string) (*Event, bool) {
+	trimmed := strings.TrimSpace(line)
+
+	kind, severity, ok := lineKind(trimmed)
+	if !ok {
+		return nil, false
+	}
+
+	event := &Event{
+		Kind:     kind,
+		Severity: severity,
+		Message:  trimmed,
+	}
+	if m := eventCodeRe.FindStringSubmatch(trimmed); m != nil {
+		event.Code = m[1]
+	}
+	if m := eventTimeRe.FindStringSubmatch(trimmed); m != nil {
+		event.Time, _ = strconv.ParseFloat(m[1], 64)
+	}
+	if m := eventCycleRe.FindStringSubmatch(trimmed); m != nil {
+		event.Cycle, _ = strconv.ParseInt(m[1], 10, 64)
+	}
+	return event, true
+}
+
+func lineKind(line string) (kind string, severity Severity, ok bool) {
+	switch {
+	case strings.HasPrefix(line, "*** Error"):
+		return "error", SeverityError, true
+	case strings.HasPrefix(line, "*** Warning"):
+		return "warning", SeverityWarning, true
+	case strings.HasPrefix(line, "*** Termination"):
+		return "termination", SeverityWarning, true
+	case strings.HasPrefix(line, "Memory required"):
+		return "memory", SeverityInfo, true
+	default:
+		return "", "", false
+	}
+}
+
+// parseProgressLine matches a periodic "cycle nnn time ... dt ..." solver
+// progress line, e.g. " cycle =    12345, time = 1.234E-02, dt = 2.345E-07".
+func parseProgressLine(line string) (ProgressSample, bool) {
+	m := progressRe.FindStringSubmatch(line)
+	if m == nil {
+		return ProgressSample{}, false
+	}
+	cycle, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return ProgressSample{}, false
+	}
+	t, err := strconv.ParseFloat(m[2], 64)
+	if err != nil {
+		return ProgressSample{}, false
+	}
+	dt, err := strconv.ParseFloat(m[3], 64)
+	if err != nil {
+		return ProgressSample{}, false
+	}
+	return ProgressSample{Cycle: cycle, Time: t, Dt: dt}, true
+}
@@ -0,0 +1,134 @@
+package main
+
+import "testing"
+
+func TestClassifyLine(t *testing.T) {
+	tests := []struct {
+		name         string
+		line         string
+		wantOK       bool
+		wantKind     string
+		wantSeverity Severity
+		wantCode     string
+		wantTime     float64
+		wantCycle    int64
+	}{
+		{
+			name:         "error with code and cycle",
+			line:         "*** Error SOL+401 termination at cycle 128",
+			wantOK:       true,
+			wantKind:     "error",
+			wantSeverity: SeverityError,
+			wantCode:     "SOL+401",
+			wantCycle:    128,
+		},
+		{
+			name:         "warning with time",
+			line:         "*** Warning shell element distorted at time = 1.5E-02",
+			wantOK:       true,
+			wantKind:     "warning",
+			wantSeverity: SeverityWarning,
+			wantTime:     1.5e-02,
+		},
+		{
+			name:         "termination notice",
+			line:         "*** Termination run complete",
+			wantOK:       true,
+			wantKind:     "termination",
+			wantSeverity: SeverityWarning,
+		},
+		{
+			name:         "memory report",
+			line:         "Memory required for solution = 123456789 words",
+			wantOK:       true,
+			wantKind:     "memory",
+			wantSeverity: SeverityInfo,
+		},
+		{
+			name:   "ordinary header line is not an event",
+			line:   " Version : mpp s R11.1.0",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, ok := classifyLine(tt.line)
+			if ok != tt.wantOK {
+				t.Fatalf("classifyLine(%q) ok = %v, want %v", tt.line, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if event.Kind != tt.wantKind {
+				t.Errorf("Kind = %q, want %q", event.Kind, tt.wantKind)
+			}
+			if event.Severity != tt.wantSeverity {
+				t.Errorf("Severity = %q, want %q", event.Severity, tt.wantSeverity)
+			}
+			if event.Code != tt.wantCode {
+				t.Errorf("Code = %q, want %q", event.Code, tt.wantCode)
+			}
+			if event.Time != tt.wantTime {
+				t.Errorf("Time = %v, want %v", event.Time, tt.wantTime)
+			}
+			if event.Cycle != tt.wantCycle {
+				t.Errorf("Cycle = %v, want %v", event.Cycle, tt.wantCycle)
+			}
+		})
+	}
+}
+
+func TestParseProgressLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		wantOK    bool
+		wantCycle int64
+		wantTime  float64
+		wantDt    float64
+	}{
+		{
+			name:      "standard progress line",
+			line:      " cycle =    12345, time = 1.234E-02, dt = 2.345E-07",
+			wantOK:    true,
+			wantCycle: 12345,
+			wantTime:  1.234e-02,
+			wantDt:    2.345e-07,
+		},
+		{
+			name:      "timestep spelled out",
+			line:      " cycle = 99, time = 0.5, timestep = 1.0E-06",
+			wantOK:    true,
+			wantCycle: 99,
+			wantTime:  0.5,
+			wantDt:    1.0e-06,
+		},
+		{
+			name:   "not a progress line",
+			line:   "*** Warning something happened",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample, ok := parseProgressLine(tt.line)
+			if ok != tt.wantOK {
+				t.Fatalf("parseProgressLine(%q) ok = %v, want %v", tt.line, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if sample.Cycle != tt.wantCycle {
+				t.Errorf("Cycle = %v, want %v", sample.Cycle, tt.wantCycle)
+			}
+			if sample.Time != tt.wantTime {
+				t.Errorf("Time = %v, want %v", sample.Time, tt.wantTime)
+			}
+			if sample.Dt != tt.wantDt {
+				t.Errorf("Dt = %v, want %v", sample.Dt, tt.wantDt)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package filter
+
+import "regexp"
+
+// Expr is a node in a parsed filter expression's AST.
+type Expr interface {
+	exprNode()
+}
+
+// BinaryExpr is a logical "and"/"or" combination of two expressions.
+type BinaryExpr struct {
+	Op    string // "and" or "or"
+	Left  Expr
+	Right Expr
+}
+
+// NotExpr negates X.
+type NotExpr struct {
+	X Expr
+}
+
+// CompareExpr compares a Record field against a literal value.
+type CompareExpr struct {
+	Field      string
+	Comparator string // "=", "!=", ">", ">=", "<", "<=", "like"
+	Value      interface{}
+
+	// likeRe is the glob in Value compiled once at parse time, so
+	// Evaluate doesn't recompile the same pattern for every Record it's
+	// run against. Only set when Comparator is "like".
+	likeRe *regexp.Regexp
+}
+
+func (*BinaryExpr) exprNode()  {}
+func (*NotExpr) exprNode()     {}
+func (*CompareExpr) exprNode() {}
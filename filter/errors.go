@@ -0,0 +1,34 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidField is wrapped into the error returned when a filter or sort
+// expression references a field name that isn't exposed to the DSL.
+var ErrInvalidField = errors.New("filter: invalid field")
+
+// ErrInvalidComparator is wrapped into the error returned when a comparator
+// isn't recognized, or isn't valid for the field's type (e.g. "like" against
+// a boolean field).
+var ErrInvalidComparator = errors.New("filter: invalid comparator")
+
+func errInvalidField(name string) error {
+	return fmt.Errorf("%w: %q", ErrInvalidField, name)
+}
+
+func errInvalidComparator(comparator string) error {
+	return fmt.Errorf("%w: %q", ErrInvalidComparator, comparator)
+}
+
+// SyntaxError is returned by Parse when the expression cannot be tokenized
+// or does not match the expression grammar.
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("filter: syntax error at position %d: %s", e.Pos, e.Msg)
+}
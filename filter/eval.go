@@ -0,0 +1,93 @@
+package filter
+
+import (
+	"fmt"
+)
+
+// Evaluate runs expr against fields, a map from DSL field name (snake_case
+// Record field names, e.g. "elapsed_time") to that field's value. Callers
+// build fields once per Record - see Record's field map in the main package -
+// so the filter package itself never needs to know about Record's type.
+func Evaluate(expr Expr, fields map[string]interface{}) (bool, error) {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		left, err := Evaluate(e.Left, fields)
+		if err != nil {
+			return false, err
+		}
+		if e.Op == "and" && !left {
+			return false, nil
+		}
+		if e.Op == "or" && left {
+			return true, nil
+		}
+		return Evaluate(e.Right, fields)
+	case *NotExpr:
+		v, err := Evaluate(e.X, fields)
+		return !v, err
+	case *CompareExpr:
+		return evalCompare(e, fields)
+	default:
+		return false, fmt.Errorf("filter: unknown expression type %T", expr)
+	}
+}
+
+func evalCompare(e *CompareExpr, fields map[string]interface{}) (bool, error) {
+	value, ok := fields[e.Field]
+	if !ok {
+		return false, errInvalidField(e.Field)
+	}
+
+	switch v := value.(type) {
+	case bool:
+		b, ok := e.Value.(bool)
+		if !ok || (e.Comparator != "=" && e.Comparator != "!=") {
+			return false, errInvalidComparator(e.Comparator)
+		}
+		if e.Comparator == "=" {
+			return v == b, nil
+		}
+		return v != b, nil
+	case float64:
+		if e.Comparator == "like" {
+			return false, errInvalidComparator(e.Comparator)
+		}
+		n, ok := e.Value.(float64)
+		if !ok {
+			return false, fmt.Errorf("filter: %q expects a number", e.Field)
+		}
+		switch e.Comparator {
+		case "=":
+			return v == n, nil
+		case "!=":
+			return v != n, nil
+		case ">":
+			return v > n, nil
+		case ">=":
+			return v >= n, nil
+		case "<":
+			return v < n, nil
+		case "<=":
+			return v <= n, nil
+		default:
+			return false, errInvalidComparator(e.Comparator)
+		}
+	case string:
+		s, ok := e.Value.(string)
+		if !ok {
+			return false, fmt.Errorf("filter: %q expects a string", e.Field)
+		}
+		switch e.Comparator {
+		case "=":
+			return v == s, nil
+		case "!=":
+			return v != s, nil
+		case "like":
+			return e.likeRe.MatchString(v), nil
+		default:
+			return false, errInvalidComparator(e.Comparator)
+		}
+	default:
+		return false, fmt.Errorf("filter: %q has unsupported field type %T", e.Field, value)
+	}
+}
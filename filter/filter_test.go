@@ -0,0 +1,96 @@
+package filter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAndEvaluate(t *testing.T) {
+	fields := map[string]interface{}{
+		"hostname":           "node03",
+		"elapsed_time":       7200.0,
+		"normal_termination": false,
+		"input_file":         "/home/user/jobs/run1/job.k",
+		"version":            "mpp s R11.1.0",
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{"equals", `hostname = "node03"`, true},
+		{"not equals", `hostname != "node03"`, false},
+		{"numeric greater than", `elapsed_time > 3600`, true},
+		{"numeric less than or equal", `elapsed_time <= 3600`, false},
+		{"bare boolean field is truthy check", `normal_termination`, false},
+		{"not", `not normal_termination`, true},
+		{"and", `hostname = "node03" and elapsed_time > 3600`, true},
+		{"or short-circuits on true", `hostname = "node03" or bogus_field = 1`, true},
+		{"and short-circuits on false", `hostname = "nope" and bogus_field = 1`, false},
+		{"parentheses", `(hostname = "nope" or hostname = "node03") and elapsed_time > 1`, true},
+		{"like glob crosses path separators", `input_file like "*.k"`, true},
+		{"like glob no match", `input_file like "*.dynain"`, false},
+		{"like against version text", `version like "mpp*"`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", tt.expr, err)
+			}
+			got, err := Evaluate(expr, fields)
+			if err != nil {
+				t.Fatalf("Evaluate(%q) returned error: %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Evaluate(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateInvalidField(t *testing.T) {
+	expr, err := Parse(`bogus_field = "x"`)
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	_, err = Evaluate(expr, map[string]interface{}{"hostname": "node03"})
+	if !errors.Is(err, ErrInvalidField) {
+		t.Fatalf("Evaluate with unknown field: got err %v, want ErrInvalidField", err)
+	}
+}
+
+func TestEvaluateInvalidComparator(t *testing.T) {
+	expr, err := Parse(`normal_termination > true`)
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	_, err = Evaluate(expr, map[string]interface{}{"normal_termination": true})
+	if !errors.Is(err, ErrInvalidComparator) {
+		t.Fatalf("Evaluate '>' against a bool field: got err %v, want ErrInvalidComparator", err)
+	}
+}
+
+func TestParseSyntaxError(t *testing.T) {
+	_, err := Parse(`hostname = `)
+	if err == nil {
+		t.Fatal("expected a syntax error for a truncated expression")
+	}
+	var syntaxErr *SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("got error %v (%T), want *SyntaxError", err, err)
+	}
+}
+
+func TestEvaluateLikeOnlyValidForStrings(t *testing.T) {
+	expr, err := Parse(`elapsed_time like "foo"`)
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	_, err = Evaluate(expr, map[string]interface{}{"elapsed_time": 1.0})
+	if err == nil {
+		t.Fatal("expected an error using 'like' against a numeric field")
+	}
+}
@@ -0,0 +1,29 @@
+package filter
+
+import (
+	"regexp"
+	"strings"
+)
+
+// globToRegexp translates a shell-style glob into an anchored regexp where
+// "*" matches any sequence of characters - including "/" - and "?" matches
+// any single character. Record fields like input_file hold filesystem paths,
+// and a query such as `input_file like "*.k"` should match regardless of how
+// many directory components come before it, so this deliberately does not
+// give "/" the path-separator semantics path/filepath.Match would apply.
+func globToRegexp(glob string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
@@ -0,0 +1,127 @@
+package filter
+
+import (
+	"strings"
+	"unicode"
+)
+
+// lexer tokenizes a filter/sort expression such as:
+//
+//	hostname like "node*" and (elapsed_time > 3600 or not normal_termination)
+type lexer struct {
+	input []rune
+	pos   int
+}
+
+func newLexer(input string) *lexer {
+	return &lexer{input: []rune(input)}
+}
+
+func (l *lexer) peek() rune {
+	if l.pos >= len(l.input) {
+		return 0
+	}
+	return l.input[l.pos]
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipSpace()
+	start := l.pos
+	if l.pos >= len(l.input) {
+		return token{kind: tokenEOF, pos: start}, nil
+	}
+
+	ch := l.peek()
+	switch {
+	case ch == '(':
+		l.pos++
+		return token{kind: tokenLParen, text: "(", pos: start}, nil
+	case ch == ')':
+		l.pos++
+		return token{kind: tokenRParen, text: ")", pos: start}, nil
+	case ch == '"' || ch == '\'':
+		return l.lexString(ch)
+	case ch == '=':
+		l.pos++
+		return token{kind: tokenComparator, text: "=", pos: start}, nil
+	case ch == '!':
+		if l.pos+1 < len(l.input) && l.input[l.pos+1] == '=' {
+			l.pos += 2
+			return token{kind: tokenComparator, text: "!=", pos: start}, nil
+		}
+		return token{}, &SyntaxError{Pos: start, Msg: "expected '!=' "}
+	case ch == '>' || ch == '<':
+		l.pos++
+		text := string(ch)
+		if l.peek() == '=' {
+			l.pos++
+			text += "="
+		}
+		return token{kind: tokenComparator, text: text, pos: start}, nil
+	case unicode.IsDigit(ch) || (ch == '-' && l.pos+1 < len(l.input) && unicode.IsDigit(l.input[l.pos+1])):
+		return l.lexNumber(), nil
+	case isIdentStart(ch):
+		return l.lexIdent(), nil
+	default:
+		return token{}, &SyntaxError{Pos: start, Msg: "unexpected character " + string(ch)}
+	}
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.input) && unicode.IsSpace(l.input[l.pos]) {
+		l.pos++
+	}
+}
+
+func (l *lexer) lexString(quote rune) (token, error) {
+	start := l.pos
+	l.pos++ // opening quote
+	var sb strings.Builder
+	for {
+		if l.pos >= len(l.input) {
+			return token{}, &SyntaxError{Pos: start, Msg: "unterminated string literal"}
+		}
+		ch := l.input[l.pos]
+		if ch == quote {
+			l.pos++
+			break
+		}
+		sb.WriteRune(ch)
+		l.pos++
+	}
+	return token{kind: tokenString, text: sb.String(), pos: start}, nil
+}
+
+func (l *lexer) lexNumber() token {
+	start := l.pos
+	if l.peek() == '-' {
+		l.pos++
+	}
+	for l.pos < len(l.input) && (unicode.IsDigit(l.input[l.pos]) || l.input[l.pos] == '.') {
+		l.pos++
+	}
+	return token{kind: tokenNumber, text: string(l.input[start:l.pos]), pos: start}
+}
+
+func (l *lexer) lexIdent() token {
+	start := l.pos
+	for l.pos < len(l.input) && isIdentPart(l.input[l.pos]) {
+		l.pos++
+	}
+	text := string(l.input[start:l.pos])
+	if kind, ok := keywords[strings.ToLower(text)]; ok {
+		return token{kind: kind, text: text, pos: start}
+	}
+	if comparators[strings.ToLower(text)] {
+		return token{kind: tokenComparator, text: strings.ToLower(text), pos: start}
+	}
+	return token{kind: tokenIdent, text: text, pos: start}
+}
+
+func isIdentStart(ch rune) bool {
+	return unicode.IsLetter(ch) || ch == '_'
+}
+
+func isIdentPart(ch rune) bool {
+	return isIdentStart(ch) || unicode.IsDigit(ch)
+}
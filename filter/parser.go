@@ -0,0 +1,176 @@
+package filter
+
+import "strconv"
+
+// Parse parses a filter expression into an AST. Grammar:
+//
+//	expr       = orExpr
+//	orExpr     = andExpr ( "or" andExpr )*
+//	andExpr    = unary ( "and" unary )*
+//	unary      = "not" unary | primary
+//	primary    = "(" expr ")" | comparison
+//	comparison = IDENT [ comparator value ]
+//	value      = STRING | NUMBER | "true" | "false"
+//
+// A comparison without a comparator is shorthand for IDENT = true.
+func Parse(expr string) (Expr, error) {
+	p := &parser{lex: newLexer(expr)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenEOF {
+		return nil, &SyntaxError{Pos: p.tok.pos, Msg: "unexpected token " + p.tok.text}
+	}
+	return node, nil
+}
+
+type parser struct {
+	lex *lexer
+	tok token
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokenOr {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryExpr{Op: "or", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokenAnd {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryExpr{Op: "and", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	if p.tok.kind == tokenNot {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{X: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	if p.tok.kind == tokenLParen {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokenRParen {
+			return nil, &SyntaxError{Pos: p.tok.pos, Msg: "expected ')'"}
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		return node, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	if p.tok.kind != tokenIdent {
+		return nil, &SyntaxError{Pos: p.tok.pos, Msg: "expected field name"}
+	}
+	field := p.tok.text
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	// A bare identifier (no comparator follows) is shorthand for a boolean
+	// field's truthy check, e.g. "normal_termination" == "normal_termination = true".
+	if p.tok.kind != tokenComparator {
+		return &CompareExpr{Field: field, Comparator: "=", Value: true}, nil
+	}
+	comparator := p.tok.text
+	if !comparators[comparator] {
+		return nil, errInvalidComparator(comparator)
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &CompareExpr{Field: field, Comparator: comparator, Value: value}
+	if comparator == "like" {
+		s, ok := value.(string)
+		if !ok {
+			return nil, &SyntaxError{Pos: p.tok.pos, Msg: "like requires a string pattern"}
+		}
+		node.likeRe = globToRegexp(s)
+	}
+	return node, nil
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	switch p.tok.kind {
+	case tokenString:
+		text := p.tok.text
+		return text, p.advance()
+	case tokenNumber:
+		text := p.tok.text
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, &SyntaxError{Pos: p.tok.pos, Msg: "invalid number " + text}
+		}
+		return n, p.advance()
+	case tokenIdent:
+		switch p.tok.text {
+		case "true":
+			return true, p.advance()
+		case "false":
+			return false, p.advance()
+		}
+		return nil, &SyntaxError{Pos: p.tok.pos, Msg: "expected value, got " + p.tok.text}
+	default:
+		return nil, &SyntaxError{Pos: p.tok.pos, Msg: "expected value"}
+	}
+}
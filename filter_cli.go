@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tenchanome/lsti/filter"
+)
+
+// Fields returns the Record's DSL-addressable fields, keyed by the
+// snake_case name used in --filter and --sort expressions.
+func (r *Record) Fields() map[string]interface{} {
+	return map[string]interface{}{
+		"elapsed_time":       r.ElapsedTime,
+		"normal_termination": r.NormalTermination,
+		"num_cpus":           float64(r.NumCpus),
+		"hostname":           r.Hostname,
+		"version":            r.Version,
+		"input_file":         r.InputFile,
+		"licensed_to":        r.LicensedTo,
+	}
+}
+
+// FilterRecords keeps only the records matching expr, an expression in the
+// filter package's query language (see filter.Parse). An empty expr matches
+// everything.
+func (cli *CLI) FilterRecords(records []*Record, expr string) ([]*Record, error) {
+	if strings.TrimSpace(expr) == "" {
+		return records, nil
+	}
+
+	ast, err := filter.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("--filter: %w", err)
+	}
+
+	filtered := records[:0]
+	for _, record := range records {
+		ok, err := filter.Evaluate(ast, record.Fields())
+		if err != nil {
+			return nil, fmt.Errorf("--filter: %w", err)
+		}
+		if ok {
+			filtered = append(filtered, record)
+		}
+	}
+	return filtered, nil
+}
+
+// SortRecords orders records in place by fields, a list of field names (same
+// names --filter accepts) compared in turn, applying order ("asc", the
+// default, or "desc") to every field.
+func (cli *CLI) SortRecords(records []*Record, fields []string, order string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	if order == "" {
+		order = "asc"
+	}
+	if order != "asc" && order != "desc" {
+		return fmt.Errorf("--order: must be \"asc\" or \"desc\", got %q", order)
+	}
+
+	known := (&Record{}).Fields()
+	for _, field := range fields {
+		if _, err := compareField(known, known, field); err != nil {
+			return fmt.Errorf("--sort: %w", err)
+		}
+	}
+
+	less := func(i, j int) bool {
+		a, b := records[i].Fields(), records[j].Fields()
+		for _, field := range fields {
+			cmp, err := compareField(a, b, field)
+			if err != nil {
+				continue
+			}
+			if cmp == 0 {
+				continue
+			}
+			if order == "desc" {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+		return false
+	}
+	sort.SliceStable(records, less)
+	return nil
+}
+
+// compareField returns -1, 0 or 1 comparing field between a and b, or an
+// error (via filter.ErrInvalidField) if the field isn't recognized.
+func compareField(a, b map[string]interface{}, field string) (int, error) {
+	av, ok := a[field]
+	if !ok {
+		return 0, filter.ErrInvalidField
+	}
+	bv := b[field]
+
+	switch av := av.(type) {
+	case float64:
+		bv := bv.(float64)
+		switch {
+		case av < bv:
+			return -1, nil
+		case av > bv:
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case string:
+		return strings.Compare(av, bv.(string)), nil
+	case bool:
+		bv := bv.(bool)
+		switch {
+		case av == bv:
+			return 0, nil
+		case !av && bv:
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	default:
+		return 0, fmt.Errorf("filter: %q has unsupported field type %T", field, av)
+	}
+}
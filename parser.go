@@ -2,27 +2,291 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"runtime"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 )
 
-// ParseMessageFiles parses LS-DYNA message files (e.g. messag, mes****) and return records.
-func (cli *CLI) ParseMessageFiles(files []string) ([]*Record, error) {
+// ParseMessageFiles parses LS-DYNA message files (e.g. messag, mes****) concurrently
+// and returns records in the same order as the (sorted) input file list.
+//
+// Files are handed out to a fixed pool of workers by a producer goroutine; a
+// collector reassembles results into the original order using the file's index
+// so that output stays stable regardless of which worker finishes first. jobs
+// controls the worker pool size; a value <= 0 defaults to runtime.NumCPU().
+// Per-file errors do not abort the batch: they are collected and returned as a
+// single aggregate error, and also written to cli.errStream so a partial batch
+// still reports what succeeded. The context allows a caller to cancel a long
+// running batch; files not yet started are skipped.
+func (cli *CLI) ParseMessageFiles(ctx context.Context, files []string, jobs int) ([]*Record, error) {
 	sort.Strings(files)
-	var records []*Record
-	for _, file := range files {
-		record, err := cli.ParseMessageFile(file)
-		if err != nil {
-			fmt.Fprintln(cli.errStream, err)
+
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+	if jobs > len(files) {
+		jobs = len(files)
+	}
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	type indexedFile struct {
+		index int
+		file  string
+	}
+	type indexedResult struct {
+		index  int
+		record *Record
+		err    error
+	}
+
+	fileCh := make(chan indexedFile)
+	resultCh := make(chan indexedResult)
+
+	var workers sync.WaitGroup
+	workers.Add(jobs)
+	for i := 0; i < jobs; i++ {
+		go func() {
+			defer workers.Done()
+			for f := range fileCh {
+				record, err := cli.ParseMessageFile(f.file)
+				resultCh <- indexedResult{index: f.index, record: record, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(fileCh)
+		for i, file := range files {
+			select {
+			case <-ctx.Done():
+				return
+			case fileCh <- indexedFile{index: i, file: file}:
+			}
+		}
+	}()
+
+	go func() {
+		workers.Wait()
+		close(resultCh)
+	}()
+
+	records := make([]*Record, len(files))
+	var errs multiError
+	for res := range resultCh {
+		if res.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", files[res.index], res.err))
+			continue
+		}
+		records[res.index] = res.record
+	}
+
+	out := records[:0]
+	for _, record := range records {
+		if record != nil {
+			out = append(out, record)
+		}
+	}
+
+	if len(errs) > 0 {
+		fmt.Fprintln(cli.errStream, errs)
+	}
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return out, errs.errOrNil()
+}
+
+const (
+	smpModule = "smp"
+	mppModule = "mpp"
+)
+
+var elapsedTimeRe = regexp.MustCompile(`^ Elapsed time\s*(\d+)\s*seconds`)
+
+// Parser holds the state needed to parse an LS-DYNA message file one line at
+// a time. It underlies both ParseMessageFile, which feeds it a whole file in
+// one pass, and CLI.Watch, which feeds it lines as they're appended to a
+// still-running job's message file.
+type Parser struct {
+	record Record
+
+	start bool
+	end   bool
+	count int
+
+	currentParent *Parent
+	moduleType    string
+}
+
+// NewParser returns a Parser for a message file known (or translated) as
+// file - this becomes Record.File on the parser's output.
+func NewParser(file string) *Parser {
+	return &Parser{record: Record{File: file}}
+}
+
+// Feed advances the parser by one line of input. Events and progress
+// samples are classified line by line as they arrive (rather than in a
+// second pass over the whole file) so that Record can be called repeatedly,
+// e.g. from Watch's debounce loop, without re-scanning lines already seen.
+func (p *Parser) Feed(line string) {
+	record := &p.record
+
+	if sample, ok := parseProgressLine(line); ok {
+		record.AddProgress(sample)
+	} else if event, ok := classifyLine(line); ok {
+		record.AddEvent(event)
+	}
+
+	// Search for header information.
+	if !p.start {
+		if strings.Contains(line, "Version : ") {
+			record.Version = parseText([]rune(line), 18, 34)
+			record.Date = parseText([]rune(line), 34, 55)
+			if strings.Contains(record.Version, "smp") {
+				p.moduleType = smpModule
+			} else if strings.Contains(record.Version, "mpp") {
+				p.moduleType = mppModule
+			}
+			return
+		}
+		if strings.Contains(line, "Revision: ") {
+			record.Revision, _ = parseInt([]rune(line), 18, 34)
+			record.Time = parseText([]rune(line), 34, 55)
+			return
+		}
+		if strings.Contains(line, "Licensed to: ") {
+			record.LicensedTo = parseText([]rune(line), 21, 55)
+			return
+		}
+		if strings.Contains(line, "Issued by  : ") {
+			record.IssuedBy = parseText([]rune(line), 21, 55)
+			return
+		}
+		if strings.Contains(line, "Platform   : ") {
+			record.Platform = parseText([]rune(line), 21, 55)
+			return
+		}
+		if strings.Contains(line, "OS Level   : ") {
+			record.Os = parseText([]rune(line), 21, 55)
+			return
+		}
+		if strings.Contains(line, "Compiler   : ") {
+			record.Compiler = parseText([]rune(line), 21, 55)
+			return
+		}
+		if strings.Contains(line, "Hostname   : ") {
+			record.Hostname = parseText([]rune(line), 21, 55)
+			return
+		}
+		if strings.Contains(line, "Precision  : ") {
+			record.Precision = parseText([]rune(line), 21, 55)
+			return
+		}
+		if strings.Contains(line, "SVN Version: ") {
+			record.SvnVersion, _ = parseInt([]rune(line), 21, 55)
+			return
+		}
+		if strings.Contains(line, "Input file: ") {
+			record.InputFile = parseText([]rune(line), 13, 84)
+			return
+		}
+		if p.moduleType == mppModule && strings.HasPrefix(line, " MPP execution with") {
+			record.NumCpus, _ = parseInt([]rune(line), 19, 27)
+			return
+		}
+	}
+
+	// Search for timing information block.
+	if strings.HasPrefix(line, " T i m i n g   i n f o r m a t i o n") {
+		p.start = true
+		return
+	}
+	if !p.start {
+		return
+	}
+
+	// Skip 2 header lines.
+	p.count++
+	if p.count <= 2 {
+		return
+	}
+
+	// If timing information block ends, stop reading.
+	if strings.Contains(line, "-----------------------") {
+		p.end = true
+		return
+	}
+
+	// Parse timing information.
+	if p.start && !p.end {
+		isParent := !strings.HasPrefix(line, "    ")
+		runes := []rune(line)
+		name := parseName(runes, 0, 25)
+		cpuSec, _ := parseFloat(runes, 25, 36)
+		cpuPercent, _ := parseFloat(runes, 36, 44)
+		clockSec, _ := parseFloat(runes, 44, 58)
+		clockPercent, _ := parseFloat(runes, 58, 66)
+		if isParent {
+			// Parent
+			p.currentParent = record.AddParent(name, cpuSec, cpuPercent, clockSec, clockPercent)
+		} else if p.currentParent != nil {
+			// Child
+			p.currentParent.AddChild(name, cpuSec, cpuPercent, clockSec, clockPercent)
 		}
-		records = append(records, record)
 	}
-	return records, nil
+
+	// Search for footer information.
+	if p.end {
+		if p.moduleType == smpModule && strings.HasPrefix(line, " Number of CPU's") {
+			record.NumCpus, _ = parseInt([]rune(line), 16, 21)
+			return
+		}
+		if strings.HasPrefix(line, " N o r m a l    t e r m i n a t i o n") {
+			record.NormalTermination = true
+			return
+		}
+		if strings.HasPrefix(line, " Elapsed time") {
+			// Use regexp because Elapsed time is not a fixed format.
+			results := elapsedTimeRe.FindStringSubmatch(line)
+			if len(results) == 2 {
+				seconds, _ := strconv.ParseFloat(results[1], 64)
+				record.ElapsedTime = seconds
+			}
+			return
+		}
+	}
+}
+
+// Done reports whether the footer has been fully read, i.e. the run is
+// known to have reached normal termination or reported its elapsed time.
+func (p *Parser) Done() bool {
+	return p.end && (p.record.NormalTermination || p.record.ElapsedTime != 0)
+}
+
+// Record returns a snapshot of the record parsed so far. The returned
+// Record does not alias any of the Parser's internal slices, so the caller
+// can hold on to it (e.g. to hand to a Sink) across further Feed calls.
+func (p *Parser) Record() *Record {
+	record := p.record
+	record.Parents = nil
+	for _, parent := range p.record.Parents {
+		copied := *parent
+		copied.Children = append([]*Child(nil), parent.Children...)
+		record.Parents = append(record.Parents, &copied)
+	}
+	record.Events = append([]*Event(nil), p.record.Events...)
+	record.Progress = append([]ProgressSample(nil), p.record.Progress...)
+	return &record
 }
 
 // ParseMessageFile parses LS-DYNA message file (e.g. messag, mes****) and return record.
@@ -46,141 +310,13 @@ func (cli *CLI) ParseMessageFile(file string) (*Record, error) {
 		}
 	}
 
-	record := Record{File: file}
+	parser := NewParser(file)
 	scanner := bufio.NewScanner(fp)
-	start := false
-	end := false
-	count := 0
-	const (
-		SMP = "smp"
-		MPP = "mpp"
-	)
-	var currentParent *Parent
-	var moduleType string
 	for scanner.Scan() {
-		line := scanner.Text()
-
-		// Search for header information.
-		if !start {
-			if strings.Contains(line, "Version : ") {
-				record.Version = parseText([]rune(line), 18, 34)
-				record.Date = parseText([]rune(line), 34, 55)
-				if strings.Contains(record.Version, "smp") {
-					moduleType = SMP
-				} else if strings.Contains(record.Version, "mpp") {
-					moduleType = MPP
-				}
-				continue
-			}
-			if strings.Contains(line, "Revision: ") {
-				record.Revision, _ = parseInt([]rune(line), 18, 34)
-				record.Time = parseText([]rune(line), 34, 55)
-				continue
-			}
-			if strings.Contains(line, "Licensed to: ") {
-				record.LicensedTo = parseText([]rune(line), 21, 55)
-				continue
-			}
-			if strings.Contains(line, "Issued by  : ") {
-				record.IssuedBy = parseText([]rune(line), 21, 55)
-				continue
-			}
-			if strings.Contains(line, "Platform   : ") {
-				record.Platform = parseText([]rune(line), 21, 55)
-				continue
-			}
-			if strings.Contains(line, "OS Level   : ") {
-				record.Os = parseText([]rune(line), 21, 55)
-				continue
-			}
-			if strings.Contains(line, "Compiler   : ") {
-				record.Compiler = parseText([]rune(line), 21, 55)
-				continue
-			}
-			if strings.Contains(line, "Hostname   : ") {
-				record.Hostname = parseText([]rune(line), 21, 55)
-				continue
-			}
-			if strings.Contains(line, "Precision  : ") {
-				record.Precision = parseText([]rune(line), 21, 55)
-				continue
-			}
-			if strings.Contains(line, "SVN Version: ") {
-				record.SvnVersion, _ = parseInt([]rune(line), 21, 55)
-				continue
-			}
-			if strings.Contains(line, "Input file: ") {
-				record.InputFile = parseText([]rune(line), 13, 84)
-				continue
-			}
-			if moduleType == MPP && strings.HasPrefix(line, " MPP execution with") {
-				record.NumCpus, _ = parseInt([]rune(line), 19, 27)
-				continue
-			}
-		}
-
-		// Search for timing information block.
-		if strings.HasPrefix(line, " T i m i n g   i n f o r m a t i o n") {
-			start = true
-			continue
-		}
-		if !start {
-			continue
-		}
-
-		// Skip 2 header lines.
-		count++
-		if count <= 2 {
-			continue
-		}
-
-		// If timing information block ends, stop reading.
-		if strings.Contains(line, "-----------------------") {
-			end = true
-			continue
-		}
-
-		// Parse timing information.
-		if start && !end {
-			isParent := !strings.HasPrefix(line, "    ")
-			runes := []rune(line)
-			name := parseName(runes, 0, 25)
-			cpuSec, _ := parseFloat(runes, 25, 36)
-			cpuPercent, _ := parseFloat(runes, 36, 44)
-			clockSec, _ := parseFloat(runes, 44, 58)
-			clockPercent, _ := parseFloat(runes, 58, 66)
-			if isParent {
-				// Parent
-				currentParent = record.AddParent(name, cpuSec, cpuPercent, clockSec, clockPercent)
-			} else {
-				// Child
-				currentParent.AddChild(name, cpuSec, cpuPercent, clockSec, clockPercent)
-			}
-		}
-
-		// Search for footer information.
-		if end {
-			if moduleType == SMP && strings.HasPrefix(line, " Number of CPU's") {
-				record.NumCpus, _ = parseInt([]rune(line), 16, 21)
-				continue
-			}
-			if strings.HasPrefix(line, " N o r m a l    t e r m i n a t i o n") {
-				record.NormalTermination = true
-				continue
-			}
-			if strings.HasPrefix(line, " Elapsed time") {
-				// Use regexp because Elapsed time is not a fixed format.
-				r := regexp.MustCompile(`^ Elapsed time\s*(\d+)\s*seconds`)
-				results := r.FindStringSubmatch(line)
-				if len(results) == 2 {
-					seconds, _ := strconv.ParseFloat(results[1], 64)
-					record.ElapsedTime = seconds
-				}
-				continue
-			}
-		}
+		parser.Feed(scanner.Text())
 	}
-	return &record, nil
+
+	return parser.Record(), nil
 }
 
 func parseName(runes []rune, start, end int) string {
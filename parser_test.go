@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// headerLine builds a fixed-column header line the way parser.go's
+// parseText(line, 21, 55) expects: label starting at column 0, value
+// starting at column 21, padded out to at least column 55.
+func headerLine(label, value string) string {
+	line := label
+	if len(line) < 21 {
+		line += strings.Repeat(" ", 21-len(line))
+	}
+	line += value
+	if len(line) < 56 {
+		line += strings.Repeat(" ", 56-len(line))
+	}
+	return line
+}
+
+// writeMessageFile writes a minimal message file whose only distinguishing
+// feature is its Hostname, padded with junkLines filler lines before the
+// header so slower-to-parse files don't necessarily finish first.
+func writeMessageFile(t *testing.T, dir, name, hostname string, junkLines int) string {
+	t.Helper()
+	var sb strings.Builder
+	for i := 0; i < junkLines; i++ {
+		sb.WriteString("some unrelated message body line that isn't part of any recognized block\n")
+	}
+	sb.WriteString(headerLine(" Version : mpp s R11.1.0", "07/01/2026") + "\n")
+	sb.WriteString(headerLine(" Hostname   : ", hostname) + "\n")
+	sb.WriteString(" T i m i n g   i n f o r m a t i o n\n")
+	sb.WriteString("dummy header 1\n")
+	sb.WriteString("dummy header 2\n")
+	sb.WriteString("-----------------------\n")
+	sb.WriteString(" N o r m a l    t e r m i n a t i o n\n")
+	sb.WriteString(" Elapsed time       1 seconds\n")
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(sb.String()), 0o644); err != nil {
+		t.Fatalf("WriteFile(%s): %v", path, err)
+	}
+	return path
+}
+
+func TestParseMessageFilesStableOrder(t *testing.T) {
+	dir := t.TempDir()
+	cli := &CLI{errStream: io.Discard}
+
+	const numFiles = 12
+	var files []string
+	for i := 0; i < numFiles; i++ {
+		name := fmt.Sprintf("mes%04d", i)
+		// Make earlier-sorted files artificially slower to parse than later
+		// ones, so a collector that used completion order instead of the
+		// original file index would reorder the output.
+		junkLines := (numFiles - i) * 200
+		files = append(files, writeMessageFile(t, dir, name, name, junkLines))
+	}
+
+	records, err := cli.ParseMessageFiles(context.Background(), files, 4)
+	if err != nil {
+		t.Fatalf("ParseMessageFiles returned error: %v", err)
+	}
+	if len(records) != numFiles {
+		t.Fatalf("got %d records, want %d", len(records), numFiles)
+	}
+
+	for i, record := range records {
+		want := fmt.Sprintf("mes%04d", i)
+		if record.Hostname != want {
+			t.Errorf("records[%d].Hostname = %q, want %q (output order must track the sorted input order, not worker completion order)", i, record.Hostname, want)
+		}
+	}
+}
+
+func TestParseMessageFilesAggregatesErrors(t *testing.T) {
+	dir := t.TempDir()
+	cli := &CLI{errStream: io.Discard}
+
+	good := writeMessageFile(t, dir, "mes0001", "good-host", 0)
+	missing := filepath.Join(dir, "mes9999-does-not-exist")
+
+	records, err := cli.ParseMessageFiles(context.Background(), []string{good, missing}, 2)
+	if err == nil {
+		t.Fatal("expected an aggregate error for the missing file")
+	}
+	if len(records) != 1 || records[0].Hostname != "good-host" {
+		t.Fatalf("expected the successfully parsed record to still be returned, got %+v", records)
+	}
+}
+
+func TestParseMessageFilesNonPositiveJobs(t *testing.T) {
+	dir := t.TempDir()
+	cli := &CLI{errStream: io.Discard}
+	file := writeMessageFile(t, dir, "mes0001", "host", 0)
+
+	// jobs <= 0 must not panic or deadlock. The pool falls back to
+	// runtime.NumCPU() workers, capped at the number of files, so the worker
+	// count itself is not observable here.
+	records, err := cli.ParseMessageFiles(context.Background(), []string{file}, 0)
+	if err != nil {
+		t.Fatalf("ParseMessageFiles returned error: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+// Record holds everything lsti extracted from a single LS-DYNA message file:
+// the header fields, the parent/child timing block, and (classified line by
+// line as the file is parsed) the warnings, errors and progress samples
+// scattered through the rest of the file.
+type Record struct {
+	File string `json:"file" yaml:"file"`
+
+	Version    string `json:"version" yaml:"version"`
+	Date       string `json:"date" yaml:"date"`
+	Revision   int64  `json:"revision" yaml:"revision"`
+	Time       string `json:"time" yaml:"time"`
+	LicensedTo string `json:"licensed_to" yaml:"licensed_to"`
+	IssuedBy   string `json:"issued_by" yaml:"issued_by"`
+	Platform   string `json:"platform" yaml:"platform"`
+	Os         string `json:"os" yaml:"os"`
+	Compiler   string `json:"compiler" yaml:"compiler"`
+	Hostname   string `json:"hostname" yaml:"hostname"`
+	Precision  string `json:"precision" yaml:"precision"`
+	SvnVersion int64  `json:"svn_version" yaml:"svn_version"`
+	InputFile  string `json:"input_file" yaml:"input_file"`
+	NumCpus    int64  `json:"num_cpus" yaml:"num_cpus"`
+
+	NormalTermination bool    `json:"normal_termination" yaml:"normal_termination"`
+	ElapsedTime       float64 `json:"elapsed_time" yaml:"elapsed_time"`
+
+	Parents []*Parent `json:"parents" yaml:"parents"`
+
+	Events   []*Event         `json:"events" yaml:"events"`
+	Progress []ProgressSample `json:"progress" yaml:"progress"`
+}
+
+// Parent is one top-level row of the "Timing information" block (e.g.
+// "Element processing", "Rigid body update").
+type Parent struct {
+	Name         string   `json:"name" yaml:"name"`
+	CpuSec       float64  `json:"cpu_sec" yaml:"cpu_sec"`
+	CpuPercent   float64  `json:"cpu_percent" yaml:"cpu_percent"`
+	ClockSec     float64  `json:"clock_sec" yaml:"clock_sec"`
+	ClockPercent float64  `json:"clock_percent" yaml:"clock_percent"`
+	Children     []*Child `json:"children,omitempty" yaml:"children,omitempty"`
+}
+
+// Child is an indented sub-row nested under a Parent.
+type Child struct {
+	Name         string  `json:"name" yaml:"name"`
+	CpuSec       float64 `json:"cpu_sec" yaml:"cpu_sec"`
+	CpuPercent   float64 `json:"cpu_percent" yaml:"cpu_percent"`
+	ClockSec     float64 `json:"clock_sec" yaml:"clock_sec"`
+	ClockPercent float64 `json:"clock_percent" yaml:"clock_percent"`
+}
+
+// AddParent appends a new top-level timing row to the record and returns it
+// so the caller can attach children to it as they're parsed.
+func (r *Record) AddParent(name string, cpuSec, cpuPercent, clockSec, clockPercent float64) *Parent {
+	parent := &Parent{
+		Name:         name,
+		CpuSec:       cpuSec,
+		CpuPercent:   cpuPercent,
+		ClockSec:     clockSec,
+		ClockPercent: clockPercent,
+	}
+	r.Parents = append(r.Parents, parent)
+	return parent
+}
+
+// AddChild appends an indented timing row under its parent.
+func (p *Parent) AddChild(name string, cpuSec, cpuPercent, clockSec, clockPercent float64) *Child {
+	child := &Child{
+		Name:         name,
+		CpuSec:       cpuSec,
+		CpuPercent:   cpuPercent,
+		ClockSec:     clockSec,
+		ClockPercent: clockPercent,
+	}
+	p.Children = append(p.Children, child)
+	return child
+}
+
+// AddEvent appends a classified line from the message body to the record.
+func (r *Record) AddEvent(event *Event) {
+	r.Events = append(r.Events, event)
+}
+
+// AddProgress appends a parsed "cycle ... time ... dt ..." progress line.
+func (r *Record) AddProgress(sample ProgressSample) {
+	r.Progress = append(r.Progress, sample)
+}
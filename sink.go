@@ -0,0 +1,82 @@
+package main
+
+import "context"
+
+// Sink is the destination for parsed Records: a terminal table, a structured
+// file format, or an external store such as Elasticsearch. The built-in
+// "list" rendering is just the TextSink implementation; other sinks are
+// selected via --output/--sink flags.
+//
+// Implementations must be safe to call Write more than once (lsti writes one
+// batch per input file as it is parsed) and must release any resources they
+// hold - open files, HTTP connections, bulk buffers - in Close, which is
+// called exactly once after the last Write.
+type Sink interface {
+	Write(ctx context.Context, records []*Record) error
+	Close() error
+}
+
+// timingDoc is the nested document shape used for a single timing row
+// (parent or child) in the NDJSON and Elasticsearch sinks; CSVSink drops
+// timing rows.
+type timingDoc struct {
+	Name         string  `json:"name" yaml:"name"`
+	Parent       string  `json:"parent,omitempty" yaml:"parent,omitempty"`
+	CpuSec       float64 `json:"cpu_sec" yaml:"cpu_sec"`
+	CpuPercent   float64 `json:"cpu_percent" yaml:"cpu_percent"`
+	ClockSec     float64 `json:"clock_sec" yaml:"clock_sec"`
+	ClockPercent float64 `json:"clock_percent" yaml:"clock_percent"`
+}
+
+// recordDoc is the flat, serializer-agnostic document shape produced from a
+// Record by Record.Document. Sinks that don't need the full Record tree
+// (NDJSON, CSV, Elasticsearch) build off of this instead of re-deriving
+// field names themselves.
+type recordDoc struct {
+	Version           string      `json:"version" yaml:"version"`
+	Revision          int64       `json:"revision" yaml:"revision"`
+	LicensedTo        string      `json:"licensed_to" yaml:"licensed_to"`
+	Platform          string      `json:"platform" yaml:"platform"`
+	Hostname          string      `json:"hostname" yaml:"hostname"`
+	InputFile         string      `json:"input_file" yaml:"input_file"`
+	NumCpus           int64       `json:"num_cpus" yaml:"num_cpus"`
+	ElapsedTime       float64     `json:"elapsed_time" yaml:"elapsed_time"`
+	NormalTermination bool        `json:"normal_termination" yaml:"normal_termination"`
+	Timings           []timingDoc `json:"timings" yaml:"timings"`
+}
+
+// Document flattens a Record (including its Parent/child timing rows) into
+// the document shape shared by the NDJSON, CSV and Elasticsearch sinks.
+func (r *Record) Document() recordDoc {
+	doc := recordDoc{
+		Version:           r.Version,
+		Revision:          r.Revision,
+		LicensedTo:        r.LicensedTo,
+		Platform:          r.Platform,
+		Hostname:          r.Hostname,
+		InputFile:         r.InputFile,
+		NumCpus:           r.NumCpus,
+		ElapsedTime:       r.ElapsedTime,
+		NormalTermination: r.NormalTermination,
+	}
+	for _, parent := range r.Parents {
+		doc.Timings = append(doc.Timings, timingDoc{
+			Name:         parent.Name,
+			CpuSec:       parent.CpuSec,
+			CpuPercent:   parent.CpuPercent,
+			ClockSec:     parent.ClockSec,
+			ClockPercent: parent.ClockPercent,
+		})
+		for _, child := range parent.Children {
+			doc.Timings = append(doc.Timings, timingDoc{
+				Name:         child.Name,
+				Parent:       parent.Name,
+				CpuSec:       child.CpuSec,
+				CpuPercent:   child.CpuPercent,
+				ClockSec:     child.ClockSec,
+				ClockPercent: child.ClockPercent,
+			})
+		}
+	}
+	return doc
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// CSVSink writes one row per Record in a flat, spreadsheet-friendly format.
+// CSV has no way to represent the nested parent/child timing rows, so those
+// are dropped here; use NDJSONSink or ElasticsearchSink if timings are needed.
+type CSVSink struct {
+	w      *csv.Writer
+	header bool
+}
+
+// NewCSVSink returns a CSVSink writing to w.
+func NewCSVSink(w io.Writer) *CSVSink {
+	return &CSVSink{w: csv.NewWriter(w)}
+}
+
+var csvHeader = []string{
+	"version", "revision", "licensed_to", "platform", "hostname",
+	"input_file", "num_cpus", "elapsed_time", "normal_termination",
+}
+
+func (s *CSVSink) Write(ctx context.Context, records []*Record) error {
+	if !s.header {
+		if err := s.w.Write(csvHeader); err != nil {
+			return err
+		}
+		s.header = true
+	}
+	for _, record := range records {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		doc := record.Document()
+		row := []string{
+			doc.Version,
+			strconv.FormatInt(doc.Revision, 10),
+			doc.LicensedTo,
+			doc.Platform,
+			doc.Hostname,
+			doc.InputFile,
+			strconv.FormatInt(doc.NumCpus, 10),
+			strconv.FormatFloat(doc.ElapsedTime, 'f', -1, 64),
+			strconv.FormatBool(doc.NormalTermination),
+		}
+		if err := s.w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *CSVSink) Close() error {
+	s.w.Flush()
+	return s.w.Error()
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ElasticsearchConfig configures ElasticsearchSink.
+type ElasticsearchConfig struct {
+	// Endpoint is the Elasticsearch/OpenSearch base URL, e.g. "https://es.example.com:9200".
+	Endpoint string
+
+	// IndexName is the target index. If IndexTemplate is set, the rollover
+	// suffix is appended to IndexName (e.g. "lsti" + "-2006.01.02").
+	IndexName string
+
+	// IndexTemplate is a time.Format layout appended to IndexName as
+	// "-<formatted time>" for time-based index rollover. Empty disables
+	// rollover and writes directly to IndexName.
+	IndexTemplate string
+
+	// Username/Password select HTTP basic auth; APIKey selects the
+	// "Authorization: ApiKey ..." header instead. At most one should be set.
+	Username string
+	Password string
+	APIKey   string
+
+	// HTTPClient is used for bulk requests; defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// ElasticsearchSink bulk-indexes records into Elasticsearch or OpenSearch
+// using the _bulk API, one document per Record (including its flattened
+// Parent/child timing rows, see Record.Document).
+type ElasticsearchSink struct {
+	cfg ElasticsearchConfig
+}
+
+// NewElasticsearchSink returns an ElasticsearchSink for cfg.
+func NewElasticsearchSink(cfg ElasticsearchConfig) *ElasticsearchSink {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	return &ElasticsearchSink{cfg: cfg}
+}
+
+func (s *ElasticsearchSink) indexName() string {
+	if s.cfg.IndexTemplate == "" {
+		return s.cfg.IndexName
+	}
+	return fmt.Sprintf("%s-%s", s.cfg.IndexName, time.Now().Format(s.cfg.IndexTemplate))
+}
+
+func (s *ElasticsearchSink) Write(ctx context.Context, records []*Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	index := s.indexName()
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, record := range records {
+		if err := enc.Encode(map[string]interface{}{
+			"index": map[string]string{"_index": index},
+		}); err != nil {
+			return err
+		}
+		if err := enc.Encode(record.Document()); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.Endpoint+"/_bulk", &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	switch {
+	case s.cfg.APIKey != "":
+		req.Header.Set("Authorization", "ApiKey "+s.cfg.APIKey)
+	case s.cfg.Username != "":
+		req.SetBasicAuth(s.cfg.Username, s.cfg.Password)
+	}
+
+	resp, err := s.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("elasticsearch bulk request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("elasticsearch bulk request: unexpected status %s", resp.Status)
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+		Items  []struct {
+			Index struct {
+				Status int `json:"status"`
+				Error  *struct {
+					Type   string `json:"type"`
+					Reason string `json:"reason"`
+				} `json:"error"`
+			} `json:"index"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("elasticsearch bulk response: %w", err)
+	}
+	if result.Errors {
+		var errs multiError
+		for _, item := range result.Items {
+			if item.Index.Error != nil {
+				errs = append(errs, fmt.Errorf("%s: %s", item.Index.Error.Type, item.Index.Error.Reason))
+			}
+		}
+		return errs.errOrNil()
+	}
+
+	return nil
+}
+
+func (s *ElasticsearchSink) Close() error {
+	return nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// NDJSONSink writes one JSON document per Record, newline-delimited, so
+// output can be streamed straight into jq, log shippers or a bulk loader.
+type NDJSONSink struct {
+	enc *json.Encoder
+}
+
+// NewNDJSONSink returns an NDJSONSink writing to w.
+func NewNDJSONSink(w io.Writer) *NDJSONSink {
+	return &NDJSONSink{enc: json.NewEncoder(w)}
+}
+
+func (s *NDJSONSink) Write(ctx context.Context, records []*Record) error {
+	for _, record := range records {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if err := s.enc.Encode(record.Document()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *NDJSONSink) Close() error {
+	return nil
+}
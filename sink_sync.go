@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// syncSink serializes access to an underlying Sink so it can be shared by
+// more than one goroutine. Sink implementations only promise that repeated,
+// sequential Write calls are safe (see the Sink doc comment) - TextSink's
+// tabwriter, CSVSink's csv.Writer and NDJSONSink's json.Encoder are not
+// safe for concurrent use. Watch runs one goroutine per followed file, so
+// it wraps whatever sink it's given in this before handing it to them.
+type syncSink struct {
+	mu   sync.Mutex
+	sink Sink
+}
+
+// newSyncSink wraps sink so its Write and Close can be called concurrently.
+func newSyncSink(sink Sink) *syncSink {
+	return &syncSink{sink: sink}
+}
+
+func (s *syncSink) Write(ctx context.Context, records []*Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sink.Write(ctx, records)
+}
+
+func (s *syncSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sink.Close()
+}
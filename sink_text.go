@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// TextSink renders records as the aligned table lsti has always printed to
+// the terminal; it is now just one Sink implementation among several.
+type TextSink struct {
+	w      *tabwriter.Writer
+	header bool
+}
+
+// NewTextSink returns a TextSink writing an aligned table to w.
+func NewTextSink(w io.Writer) *TextSink {
+	return &TextSink{w: tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)}
+}
+
+func (s *TextSink) Write(ctx context.Context, records []*Record) error {
+	if !s.header {
+		fmt.Fprintln(s.w, "VERSION\tHOSTNAME\tINPUT FILE\tNUM CPUS\tELAPSED TIME\tNORMAL TERMINATION")
+		s.header = true
+	}
+	for _, record := range records {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		fmt.Fprintf(s.w, "%s\t%s\t%s\t%d\t%.0fs\t%t\n",
+			record.Version, record.Hostname, record.InputFile,
+			record.NumCpus, record.ElapsedTime, record.NormalTermination)
+	}
+	return nil
+}
+
+func (s *TextSink) Close() error {
+	return s.w.Flush()
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// defaultWatchDebounce is how often Watch emits a Record snapshot to the
+// sink while a file is still growing.
+const defaultWatchDebounce = 2 * time.Second
+
+// Watch follows each of files the way --follow/-f does: it keeps reading as
+// content is appended, incrementally re-parsing with a Parser, and writes a
+// Record snapshot to sink every debounce interval (debounce <= 0 defaults to
+// defaultWatchDebounce) so a still-running solver can be monitored without
+// waiting for "Normal termination". It returns once every file has reached
+// its footer or ctx is cancelled; per-file errors are aggregated the same
+// way ParseMessageFiles aggregates them.
+//
+// Watch runs one goroutine per file, all writing to sink, so sink is
+// wrapped to serialize those calls; Sink implementations themselves only
+// need to support repeated sequential Write calls, not concurrent ones.
+func (cli *CLI) Watch(ctx context.Context, files []string, sink Sink, debounce time.Duration) error {
+	if debounce <= 0 {
+		debounce = defaultWatchDebounce
+	}
+	sink = newSyncSink(sink)
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(files))
+	wg.Add(len(files))
+	for _, file := range files {
+		file := file
+		go func() {
+			defer wg.Done()
+			if err := cli.watchFile(ctx, file, sink, debounce); err != nil {
+				errCh <- fmt.Errorf("%s: %w", file, err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+
+	var errs multiError
+	for err := range errCh {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		fmt.Fprintln(cli.errStream, errs)
+	}
+	return errs.errOrNil()
+}
+
+func (cli *CLI) watchFile(ctx context.Context, file string, sink Sink, debounce time.Duration) error {
+	fp, err := os.Open(filepath.FromSlash(file))
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	parser := NewParser(file)
+
+	changed := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+
+	watcher, watchErr := fsnotify.NewWatcher()
+	if watchErr == nil {
+		if err := watcher.Add(filepath.Dir(file)); err != nil {
+			watcher.Close()
+			watcher = nil
+		}
+	} else {
+		watcher = nil
+	}
+
+	notifyCtx, cancelNotify := context.WithCancel(ctx)
+	defer cancelNotify()
+
+	if watcher != nil {
+		defer watcher.Close()
+		go func() {
+			for {
+				select {
+				case event, ok := <-watcher.Events:
+					if !ok {
+						return
+					}
+					if filepath.Clean(event.Name) == filepath.Clean(file) {
+						notify()
+					}
+				case _, ok := <-watcher.Errors:
+					if !ok {
+						return
+					}
+				case <-notifyCtx.Done():
+					return
+				}
+			}
+		}()
+	} else {
+		// Polling fallback for filesystems fsnotify can't watch (some
+		// network mounts, or platforms without inotify/kqueue support).
+		go func() {
+			poll := time.NewTicker(500 * time.Millisecond)
+			defer poll.Stop()
+			for {
+				select {
+				case <-poll.C:
+					notify()
+				case <-notifyCtx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	// pending holds bytes read since the last complete line: a write that
+	// lands mid-line must not be fed to the Parser until its newline
+	// arrives, or fixed-column/regex parsing would see a truncated line.
+	var pending []byte
+	readBuf := make([]byte, 4096)
+
+	readAppended := func() (appended bool) {
+		for {
+			n, err := fp.Read(readBuf)
+			if n > 0 {
+				appended = true
+				pending = append(pending, readBuf[:n]...)
+				for {
+					idx := bytes.IndexByte(pending, '\n')
+					if idx < 0 {
+						break
+					}
+					parser.Feed(string(pending[:idx]))
+					pending = pending[idx+1:]
+				}
+			}
+			if err != nil {
+				return appended
+			}
+		}
+	}
+
+	// flushPending feeds a final trailing line even if it never got a
+	// newline, for when the file ends (EOF for good, not just "no more
+	// bytes yet") without one.
+	flushPending := func() {
+		if len(pending) > 0 {
+			parser.Feed(string(pending))
+			pending = nil
+		}
+	}
+
+	emit := func(ctx context.Context) error {
+		return sink.Write(ctx, []*Record{parser.Record()})
+	}
+
+	ticker := time.NewTicker(debounce)
+	defer ticker.Stop()
+
+	dirty := false
+	emitted := false
+	for !parser.Done() {
+		select {
+		case <-ctx.Done():
+			readAppended()
+			flushPending()
+			// Flush whatever was read with a background context: ctx is
+			// already cancelled, and a cancelled sink write would discard
+			// the final snapshot of a run that may have just finished.
+			return emit(context.Background())
+		case <-changed:
+			if readAppended() {
+				dirty = true
+			}
+		case <-ticker.C:
+			if readAppended() {
+				dirty = true
+			}
+			if !dirty {
+				continue
+			}
+			if err := emit(ctx); err != nil {
+				return err
+			}
+			dirty = false
+			emitted = parser.Done()
+		}
+	}
+
+	// The in-loop emit above already wrote the final snapshot if the footer
+	// was read and flushed there; only emit again if there's content it
+	// hasn't seen yet - either unread bytes, or a trailing line already
+	// buffered in pending (e.g. the line that made Done() true fed a
+	// newline-terminated field but left a still-unterminated one behind).
+	if readAppended() || len(pending) > 0 {
+		emitted = false
+	}
+	flushPending()
+	if emitted {
+		return nil
+	}
+	return emit(context.Background())
+}